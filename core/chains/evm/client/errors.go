@@ -342,6 +342,25 @@ func extractRPCError(baseErr error) (*JsonError, error) {
 	return &jErr, nil
 }
 
+// ExtractRevertDataFromRPCError returns the hex encoded "data" field of the response of an RPC eth_call
+// that reverted. Unlike ExtractRevertReasonFromRPCError it does not decode it, so it is also usable for
+// custom errors, e.g. a bare error selector that has no revert reason.
+func ExtractRevertDataFromRPCError(err error) (string, error) {
+	jErr, eErr := extractRPCError(err)
+	if eErr != nil {
+		return "", eErr
+	}
+	dataStr, ok := jErr.Data.(string)
+	if !ok {
+		return "", errors.New("invalid error type")
+	}
+	data := hexDataRegex.FindString(dataStr)
+	if data == "" {
+		return "", errors.New("unknown data payload format")
+	}
+	return data, nil
+}
+
 // ExtractRevertReasonFromRPCError attempts to extract the revert reason from the response of
 // an RPC eth_call that reverted by parsing the message from the "data" field
 // ex:
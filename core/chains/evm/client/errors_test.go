@@ -313,6 +313,35 @@ func Test_Eth_Errors_Fatal(t *testing.T) {
 	}
 }
 
+func Test_ExtractRevertDataFromRPCError(t *testing.T) {
+	t.Parallel()
+
+	t.Run("it extracts the data of custom errors", func(t *testing.T) {
+		var jsonErr error = &evmclient.JsonError{
+			Code:    3,
+			Data:    "0x12345678",
+			Message: "execution reverted",
+		}
+		data, err := evmclient.ExtractRevertDataFromRPCError(errors.Wrap(jsonErr, "wrapped message"))
+		require.NoError(t, err)
+		require.Equal(t, "0x12345678", data)
+	})
+
+	t.Run("it gracefully errors when no data present", func(t *testing.T) {
+		var jsonErr error = &evmclient.JsonError{
+			Code:    3,
+			Message: "execution reverted",
+		}
+		_, err := evmclient.ExtractRevertDataFromRPCError(jsonErr)
+		require.Error(t, err)
+	})
+
+	t.Run("gracefully errors when given a normal error", func(t *testing.T) {
+		_, err := evmclient.ExtractRevertDataFromRPCError(errors.New("normal error"))
+		require.Error(t, err)
+	})
+}
+
 func Test_ExtractRevertReasonFromRPCError(t *testing.T) {
 	t.Parallel()
 
@@ -27,6 +27,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 	"github.com/smartcontractkit/chainlink/core/services/pg"
 	"github.com/smartcontractkit/chainlink/core/utils"
+	bigmath "github.com/smartcontractkit/chainlink/core/utils/big_math"
 )
 
 const (
@@ -331,6 +332,10 @@ func (eb *EthBroadcaster) processUnstartedEthTxs(ctx context.Context, fromAddres
 			if err != nil {
 				return errors.Wrap(err, "failed to get dynamic gas fee"), true
 			}
+			if price := eb.requestedGasPrice(*etx, keySpecificMaxGasPriceWei); price != nil {
+				// The fee cap may not be below the tip, which is already at least EvmGasTipCapMinimum
+				fee.FeeCap = bigmath.Max(price, fee.TipCap)
+			}
 			a, err = eb.NewDynamicFeeAttempt(*etx, fee, gasLimit)
 			if err != nil {
 				return errors.Wrap(err, "processUnstartedEthTxs failed on NewDynamicFeeAttempt"), true
@@ -340,6 +345,9 @@ func (eb *EthBroadcaster) processUnstartedEthTxs(ctx context.Context, fromAddres
 			if err != nil {
 				return errors.Wrap(err, "failed to estimate gas"), true
 			}
+			if price := eb.requestedGasPrice(*etx, keySpecificMaxGasPriceWei); price != nil {
+				gasPrice = bigmath.Max(price, eb.config.EvmMinGasPriceWei())
+			}
 			a, err = eb.NewLegacyAttempt(*etx, gasPrice, gasLimit)
 			if err != nil {
 				return errors.Wrap(err, "processUnstartedEthTxs failed on NewLegacyAttempt"), true
@@ -359,6 +367,24 @@ func (eb *EthBroadcaster) processUnstartedEthTxs(ctx context.Context, fromAddres
 	}
 }
 
+// requestedGasPrice returns the gas price the transaction was created with (see EthTxMeta.GasPrice),
+// capped at keySpecificMaxGasPriceWei, or nil if it should be priced by the estimator
+func (eb *EthBroadcaster) requestedGasPrice(etx EthTx, keySpecificMaxGasPriceWei *big.Int) *big.Int {
+	meta, err := etx.GetMeta()
+	if err != nil {
+		eb.logger.Errorw("Failed to read eth_tx meta, using estimated gas price", "etxID", etx.ID, "err", err)
+		return nil
+	}
+	if meta == nil || meta.GasPrice == nil {
+		return nil
+	}
+	price := meta.GasPrice.ToInt()
+	if keySpecificMaxGasPriceWei != nil && price.Cmp(keySpecificMaxGasPriceWei) > 0 {
+		return keySpecificMaxGasPriceWei
+	}
+	return price
+}
+
 // handleInProgressEthTx checks if there is any transaction
 // in_progress and if so, finishes the job
 func (eb *EthBroadcaster) handleAnyInProgressEthTx(ctx context.Context, fromAddress gethCommon.Address) (err error, retryable bool) {
@@ -656,6 +656,80 @@ func TestEthBroadcaster_ProcessUnstartedEthTxs_Success_WithMultiplier(t *testing
 	}
 }
 
+func TestEthBroadcaster_ProcessUnstartedEthTxs_RequestedGasPrice(t *testing.T) {
+	toAddress := gethCommon.HexToAddress("0x6C03DDA95a2AEd917EeCc6eddD4b9D16E6380411")
+
+	insertTx := func(t *testing.T, borm txmgr.ORM, fromAddress gethCommon.Address, gasPrice *big.Int) txmgr.EthTx {
+		b, err := json.Marshal(txmgr.EthTxMeta{GasPrice: utils.NewBig(gasPrice)})
+		require.NoError(t, err)
+		meta := datatypes.JSON(b)
+		etx := txmgr.EthTx{
+			FromAddress:    fromAddress,
+			ToAddress:      toAddress,
+			EncodedPayload: []byte{42, 42, 0},
+			Value:          assets.NewEthValue(242),
+			GasLimit:       1231,
+			CreatedAt:      time.Unix(0, 0),
+			State:          txmgr.EthTxUnstarted,
+			Meta:           &meta,
+		}
+		require.NoError(t, borm.InsertEthTx(&etx))
+		return etx
+	}
+
+	tests := []struct {
+		name          string
+		eip1559       bool
+		requested     *big.Int
+		wantGasPrice  *big.Int
+		wantGasFeeCap *big.Int
+	}{
+		{"legacy", false, big.NewInt(30e9), big.NewInt(30e9), nil},
+		{"legacy above max gas price", false, big.NewInt(900e9), big.NewInt(500e9), nil},
+		{"EIP-1559", true, big.NewInt(30e9), nil, big.NewInt(30e9)},
+		{"EIP-1559 below tip cap", true, big.NewInt(1), nil, big.NewInt(2e9)},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			db := pgtest.NewSqlxDB(t)
+			cfg := cltest.NewTestGeneralConfig(t)
+			cfg.Overrides.GlobalEvmEIP1559DynamicFees = null.BoolFrom(test.eip1559)
+			cfg.Overrides.GlobalEvmGasPriceDefault = big.NewInt(20e9)
+			cfg.Overrides.GlobalEvmGasTipCapDefault = big.NewInt(2e9)
+			cfg.Overrides.GlobalEvmGasFeeCapDefault = big.NewInt(100e9)
+			cfg.Overrides.GlobalEvmMaxGasPriceWei = big.NewInt(500e9)
+			borm := cltest.NewTxmORM(t, db, cfg)
+			evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+
+			ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+			keyState, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore, 0)
+
+			ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+			eb := cltest.NewEthBroadcaster(t, db, ethClient, ethKeyStore, evmcfg, []ethkey.State{keyState}, &testCheckerFactory{})
+			ethClient.On("SendTransaction", mock.Anything, mock.Anything).Return(nil).Once()
+
+			etx := insertTx(t, borm, fromAddress, test.requested)
+
+			err, retryable := eb.ProcessUnstartedEthTxs(testutils.Context(t), keyState)
+			require.NoError(t, err)
+			require.False(t, retryable)
+
+			etx, err = borm.FindEthTxWithAttempts(etx.ID)
+			require.NoError(t, err)
+			require.Len(t, etx.EthTxAttempts, 1)
+			attempt := etx.EthTxAttempts[0]
+			if test.eip1559 {
+				assert.Nil(t, attempt.GasPrice)
+				assert.Equal(t, big.NewInt(2e9).String(), attempt.GasTipCap.String())
+				assert.Equal(t, test.wantGasFeeCap.String(), attempt.GasFeeCap.String())
+			} else {
+				assert.Equal(t, test.wantGasPrice.String(), attempt.GasPrice.String())
+			}
+		})
+	}
+}
+
 func TestEthBroadcaster_ProcessUnstartedEthTxs_ResumingFromCrash(t *testing.T) {
 	toAddress := gethCommon.HexToAddress("0x6C03DDA95a2AEd917EeCc6eddD4b9D16E6380411")
 	value := assets.NewEthValue(142)
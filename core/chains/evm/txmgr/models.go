@@ -32,6 +32,9 @@ type EthTxMeta struct {
 
 	// Pipeline fields
 	FailOnRevert null.Bool `json:"FailOnRevert,omitempty"`
+	// GasPrice, if set, is bid on the first attempt instead of the estimator's price (or fee cap on
+	// EIP-1559 chains). It is capped at the key specific max gas price, and bumped as usual afterwards.
+	GasPrice *utils.Big `json:"GasPrice,omitempty"`
 
 	// VRF-only fields
 	RequestID     *common.Hash `json:"RequestID,omitempty"`
@@ -124,6 +124,7 @@ type GeneralOnlyConfig interface {
 	JobPipelineReaperInterval() time.Duration
 	JobPipelineReaperThreshold() time.Duration
 	JobPipelineResultWriteQueueDepth() uint64
+	KeeperCheckJitter() time.Duration
 	KeeperDefaultTransactionQueueDepth() uint32
 	KeeperGasPriceBufferPercent() uint32
 	KeeperGasTipCapBufferPercent() uint32
@@ -892,6 +893,12 @@ func (c *generalConfig) KeeperCheckUpkeepGasPriceFeatureEnabled() bool {
 	return getEnvWithFallback(c, envvar.NewBool("KeeperCheckUpkeepGasPriceFeatureEnabled"))
 }
 
+// KeeperCheckJitter is the maximum random delay added before each upkeep check, used to spread
+// the RPC load of keepers checking the same upkeeps at the same head
+func (c *generalConfig) KeeperCheckJitter() time.Duration {
+	return getEnvWithFallback(c, envvar.NewDuration("KeeperCheckJitter"))
+}
+
 // KeeperTurnLookBack represents the number of blocks in the past to loo back when getting block for turn
 func (c *generalConfig) KeeperTurnLookBack() int64 {
 	return c.viper.GetInt64(envvar.Name("KeeperTurnLookBack"))
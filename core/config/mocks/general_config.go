@@ -2110,6 +2110,20 @@ func (_m *GeneralConfig) KeeperBaseFeeBufferPercent() uint32 {
 	return r0
 }
 
+// KeeperCheckJitter provides a mock function with given fields:
+func (_m *GeneralConfig) KeeperCheckJitter() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
 // KeeperCheckUpkeepGasPriceFeatureEnabled provides a mock function with given fields:
 func (_m *GeneralConfig) KeeperCheckUpkeepGasPriceFeatureEnabled() bool {
 	ret := _m.Called()
@@ -71,6 +71,7 @@ type EnvPrinter struct {
 	KeeperCheckUpkeepGasPriceFeatureEnabled    bool            `json:"KEEPER_CHECK_UPKEEP_GAS_PRICE_FEATURE_ENABLED"`
 	KeeperTurnLookBack                         int64           `json:"KEEPER_TURN_LOOK_BACK"`
 	KeeperTurnFlagEnabled                      bool            `json:"KEEPER_TURN_FLAG_ENABLED"`
+	KeeperCheckJitter                          time.Duration   `json:"KEEPER_CHECK_JITTER"`
 	LeaseLockDuration                          time.Duration   `json:"LEASE_LOCK_DURATION"`
 	LeaseLockRefreshInterval                   time.Duration   `json:"LEASE_LOCK_REFRESH_INTERVAL"`
 	FlagsContractAddress                       string          `json:"FLAGS_CONTRACT_ADDRESS"`
@@ -186,6 +187,7 @@ func NewConfigPrinter(cfg GeneralConfig) ConfigPrinter {
 			KeeperCheckUpkeepGasPriceFeatureEnabled: cfg.KeeperCheckUpkeepGasPriceFeatureEnabled(),
 			KeeperTurnLookBack:                      cfg.KeeperTurnLookBack(),
 			KeeperTurnFlagEnabled:                   cfg.KeeperTurnFlagEnabled(),
+			KeeperCheckJitter:                       cfg.KeeperCheckJitter(),
 
 			LeaseLockDuration:        cfg.LeaseLockDuration(),
 			LeaseLockRefreshInterval: cfg.LeaseLockRefreshInterval(),
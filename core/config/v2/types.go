@@ -290,6 +290,7 @@ type Keeper struct {
 	TurnLookBack                 *int64
 	TurnFlagEnabled              *bool
 	UpkeepCheckGasPriceEnabled   *bool
+	CheckJitter                  *models.Duration
 }
 
 type AutoPprof struct {
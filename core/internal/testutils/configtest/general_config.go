@@ -87,6 +87,7 @@ type GeneralConfigOverrides struct {
 	GlobalMinIncomingConfirmations          null.Int
 	GlobalMinimumContractPayment            *assets.Link
 	GlobalOCRObservationGracePeriod         time.Duration
+	KeeperCheckJitter                       *time.Duration
 	KeeperCheckUpkeepGasPriceFeatureEnabled null.Bool
 	KeeperMaximumGracePeriod                null.Int
 	KeeperRegistrySyncInterval              *time.Duration
@@ -442,6 +443,13 @@ func (c *TestGeneralConfig) KeeperRegistrySyncUpkeepQueueSize() uint32 {
 	return c.GeneralConfig.KeeperRegistrySyncUpkeepQueueSize()
 }
 
+func (c *TestGeneralConfig) KeeperCheckJitter() time.Duration {
+	if c.Overrides.KeeperCheckJitter != nil {
+		return *c.Overrides.KeeperCheckJitter
+	}
+	return c.GeneralConfig.KeeperCheckJitter()
+}
+
 // KeeperCheckUpkeepGasPriceFeatureEnabled overrides
 func (c *TestGeneralConfig) KeeperCheckUpkeepGasPriceFeatureEnabled() bool {
 	if c.Overrides.KeeperCheckUpkeepGasPriceFeatureEnabled.Valid {
@@ -863,6 +863,7 @@ func (c *Config) loadLegacyCoreEnv() {
 		TurnLookBack:                 envvar.NewInt64("KeeperTurnLookBack").ParsePtr(),
 		TurnFlagEnabled:              envvar.NewBool("KeeperTurnFlagEnabled").ParsePtr(),
 		UpkeepCheckGasPriceEnabled:   envvar.NewBool("KeeperCheckUpkeepGasPriceFeatureEnabled").ParsePtr(),
+		CheckJitter:                  envDuration("KeeperCheckJitter"),
 	}
 	if isZeroPtr(c.Keeper) {
 		c.Keeper = nil
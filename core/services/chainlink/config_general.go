@@ -369,6 +369,10 @@ func (g *generalConfig) KeeperTurnFlagEnabled() bool {
 	return *g.c.Keeper.TurnFlagEnabled
 }
 
+func (g *generalConfig) KeeperCheckJitter() time.Duration {
+	return g.c.Keeper.CheckJitter.Duration()
+}
+
 func (g *generalConfig) KeyFile() string {
 	if g.TLSKeyPath() == "" {
 		return filepath.Join(g.TLSDir(), "server.key")
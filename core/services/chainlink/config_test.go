@@ -342,6 +342,7 @@ func TestConfig_Marshal(t *testing.T) {
 		TurnLookBack:                 ptr[int64](91),
 		TurnFlagEnabled:              ptr(true),
 		UpkeepCheckGasPriceEnabled:   ptr(true),
+		CheckJitter:                  models.MustNewDuration(time.Minute),
 	}
 	full.AutoPprof = &config.AutoPprof{
 		Enabled:              ptr(true),
@@ -696,6 +697,7 @@ RegistrySyncUpkeepQueueSize = 31
 TurnLookBack = 91
 TurnFlagEnabled = true
 UpkeepCheckGasPriceEnabled = true
+CheckJitter = '1m0s'
 `},
 		{"AutoPprof", Config{Core: config.Core{AutoPprof: full.AutoPprof}}, `
 [AutoPprof]
@@ -404,6 +404,90 @@ func TestORM_CreateJob_VRFV2(t *testing.T) {
 	cltest.AssertCount(t, db, "jobs", 0)
 }
 
+func TestORM_CreateJob_KeeperGasOracle(t *testing.T) {
+	config := evmtest.NewChainScopedConfig(t, cltest.NewTestGeneralConfig(t))
+	db := pgtest.NewSqlxDB(t)
+	keyStore := cltest.NewKeyStore(t, db, config)
+
+	pipelineORM := pipeline.NewORM(db, logger.TestLogger(t), config)
+	cc := evmtest.NewChainSet(t, evmtest.TestChainOpts{DB: db, GeneralConfig: config})
+	jobORM := job.NewTestORM(t, db, cc, pipelineORM, keyStore, config)
+
+	gasOracleAddress := cltest.NewEIP55Address()
+	jb, err := keeper.ValidatedKeeperSpec(fmt.Sprintf(`
+type             = "keeper"
+schemaVersion    = 1
+name             = "keeper with gas oracle"
+contractAddress  = "%s"
+fromAddress      = "%s"
+gasOracleAddress = "%s"
+gasOracleMaxAge  = "1h"
+evmChainID       = %s
+externalJobID    = "123e4567-e89b-12d3-a456-426655440002"
+`, cltest.NewEIP55Address(), cltest.NewEIP55Address(), gasOracleAddress, cltest.FixtureChainID.String()))
+	require.NoError(t, err)
+
+	require.NoError(t, jobORM.CreateJob(&jb))
+	cltest.AssertCount(t, db, "keeper_specs", 1)
+	cltest.AssertCount(t, db, "jobs", 1)
+
+	var dbGasOracleAddress ethkey.EIP55Address
+	require.NoError(t, db.Get(&dbGasOracleAddress, `SELECT gas_oracle_address FROM keeper_specs LIMIT 1`))
+	require.Equal(t, gasOracleAddress, dbGasOracleAddress)
+
+	found, err := jobORM.FindJob(testutils.Context(t), jb.ID)
+	require.NoError(t, err)
+	require.NotNil(t, found.KeeperSpec.GasOracleAddress)
+	require.Equal(t, gasOracleAddress, *found.KeeperSpec.GasOracleAddress)
+	require.Equal(t, time.Hour, found.KeeperSpec.GasOracleMaxAge.Duration())
+
+	require.NoError(t, jobORM.DeleteJob(jb.ID))
+	cltest.AssertCount(t, db, "keeper_specs", 0)
+	cltest.AssertCount(t, db, "jobs", 0)
+}
+
+func TestORM_CreateJob_KeeperStateOverrides(t *testing.T) {
+	config := evmtest.NewChainScopedConfig(t, cltest.NewTestGeneralConfig(t))
+	db := pgtest.NewSqlxDB(t)
+	keyStore := cltest.NewKeyStore(t, db, config)
+
+	pipelineORM := pipeline.NewORM(db, logger.TestLogger(t), config)
+	cc := evmtest.NewChainSet(t, evmtest.TestChainOpts{DB: db, GeneralConfig: config})
+	jobORM := job.NewTestORM(t, db, cc, pipelineORM, keyStore, config)
+
+	fromAddress := cltest.NewEIP55Address()
+	jb, err := keeper.ValidatedKeeperSpec(fmt.Sprintf(`
+type            = "keeper"
+schemaVersion   = 1
+name            = "keeper with state overrides"
+contractAddress = "%s"
+fromAddress     = "%s"
+stateOverrides  = { "%s" = { balance = "0xde0b6b3a7640000" } }
+evmChainID      = %s
+externalJobID   = "123e4567-e89b-12d3-a456-426655440002"
+`, cltest.NewEIP55Address(), fromAddress, fromAddress, cltest.FixtureChainID.String()))
+	require.NoError(t, err)
+
+	require.NoError(t, jobORM.CreateJob(&jb))
+	cltest.AssertCount(t, db, "keeper_specs", 1)
+
+	expected := job.JSONConfig{
+		fromAddress.String(): map[string]interface{}{"balance": "0xde0b6b3a7640000"},
+	}
+
+	var dbStateOverrides job.JSONConfig
+	require.NoError(t, db.Get(&dbStateOverrides, `SELECT state_overrides FROM keeper_specs LIMIT 1`))
+	require.Equal(t, expected, dbStateOverrides)
+
+	found, err := jobORM.FindJob(testutils.Context(t), jb.ID)
+	require.NoError(t, err)
+	require.Equal(t, expected, found.KeeperSpec.StateOverrides)
+
+	require.NoError(t, jobORM.DeleteJob(jb.ID))
+	cltest.AssertCount(t, db, "keeper_specs", 0)
+	cltest.AssertCount(t, db, "jobs", 0)
+}
+
 func TestORM_CreateJob_OCRBootstrap(t *testing.T) {
 	config := evmtest.NewChainScopedConfig(t, cltest.NewTestGeneralConfig(t))
 	db := pgtest.NewSqlxDB(t)
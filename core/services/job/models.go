@@ -407,8 +407,19 @@ type KeeperSpec struct {
 	MinIncomingConfirmations *uint32             `toml:"minIncomingConfirmations"`
 	FromAddress              ethkey.EIP55Address `toml:"fromAddress"`
 	EVMChainID               *utils.Big          `toml:"evmChainID"`
-	CreatedAt                time.Time           `toml:"-"`
-	UpdatedAt                time.Time           `toml:"-"`
+	// GasOracleAddress is an optional on-chain gas price feed (e.g. the
+	// registry's fast gas feed) used to price checkUpkeep calls instead of
+	// the node's gas estimator.
+	GasOracleAddress *ethkey.EIP55Address `toml:"gasOracleAddress"`
+	// GasOracleMaxAge is how long ago the gas oracle may have last been
+	// updated before its answer is ignored. Defaults to 24h if unset.
+	GasOracleMaxAge models.Interval `toml:"gasOracleMaxAge"`
+	// StateOverrides is an optional eth_call state override set
+	// (address => balance/nonce/code/state/stateDiff) applied to
+	// checkUpkeep calls.
+	StateOverrides JSONConfig `toml:"stateOverrides"`
+	CreatedAt      time.Time  `toml:"-"`
+	UpdatedAt      time.Time  `toml:"-"`
 }
 
 type VRFSpec struct {
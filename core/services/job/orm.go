@@ -277,8 +277,8 @@ func (o *orm) CreateJob(jb *Job, qopts ...pg.QOpt) error {
 			jb.OCR2OracleSpecID = &specID
 		case Keeper:
 			var specID int32
-			sql := `INSERT INTO keeper_specs (contract_address, from_address, evm_chain_id, created_at, updated_at)
-			VALUES (:contract_address, :from_address, :evm_chain_id, NOW(), NOW())
+			sql := `INSERT INTO keeper_specs (contract_address, from_address, evm_chain_id, gas_oracle_address, gas_oracle_max_age, state_overrides, created_at, updated_at)
+			VALUES (:contract_address, :from_address, :evm_chain_id, :gas_oracle_address, :gas_oracle_max_age, :state_overrides, NOW(), NOW())
 			RETURNING id;`
 			if err := pg.PrepareQueryRowx(tx, sql, &specID, jb.KeeperSpec); err != nil {
 				return errors.Wrap(err, "failed to create KeeperSpec")
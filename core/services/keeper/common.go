@@ -7,12 +7,14 @@ import (
 	gethcommon "github.com/ethereum/go-ethereum/common"
 
 	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/gethwrappers/generated/aggregator_v3_interface"
 	"github.com/smartcontractkit/chainlink/core/gethwrappers/generated/keeper_registry_wrapper1_1"
 	"github.com/smartcontractkit/chainlink/core/gethwrappers/generated/keeper_registry_wrapper1_2"
 )
 
 var Registry1_1ABI = evmtypes.MustGetABI(keeper_registry_wrapper1_1.KeeperRegistryABI)
 var Registry1_2ABI = evmtypes.MustGetABI(keeper_registry_wrapper1_2.KeeperRegistryABI)
+var GasOracleABI = evmtypes.MustGetABI(aggregator_v3_interface.AggregatorV3InterfaceABI)
 
 type Config interface {
 	EvmEIP1559DynamicFees() bool
@@ -27,6 +29,7 @@ type Config interface {
 	KeeperRegistrySyncInterval() time.Duration
 	KeeperRegistrySyncUpkeepQueueSize() uint32
 	KeeperCheckUpkeepGasPriceFeatureEnabled() bool
+	KeeperCheckJitter() time.Duration
 	KeeperTurnLookBack() int64
 	KeeperTurnFlagEnabled() bool
 	LogSQL() bool
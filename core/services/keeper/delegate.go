@@ -72,6 +72,10 @@ func (d *Delegate) ServicesForSpec(spec job.Job) (services []job.ServiceCtx, err
 	}
 	svcLogger.Info("Registry version is: ", registryWrapper.Version)
 
+	if spec.KeeperSpec.GasOracleAddress != nil && !chain.Config().KeeperCheckUpkeepGasPriceFeatureEnabled() {
+		svcLogger.Warnw("gasOracleAddress is ignored because KEEPER_CHECK_UPKEEP_GAS_PRICE_FEATURE_ENABLED is false", "gasOracleAddress", spec.KeeperSpec.GasOracleAddress)
+	}
+
 	minIncomingConfirmations := chain.Config().MinIncomingConfirmations()
 	if spec.KeeperSpec.MinIncomingConfirmations != nil {
 		minIncomingConfirmations = *spec.KeeperSpec.MinIncomingConfirmations
@@ -4,10 +4,14 @@ import (
 	"context"
 	"fmt"
 	"math/big"
+	mrand "math/rand"
+	"strings"
 	"sync"
 	"time"
 
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
@@ -26,6 +30,14 @@ import (
 
 const (
 	executionQueueSize = 10
+	// checkDeadline bounds the execution of a single upkeep check
+	checkDeadline = time.Minute
+	// maxCheckJitter caps KeeperCheckJitter so that delayed checks still complete before checkDeadline
+	maxCheckJitter = checkDeadline / 2
+	// defaultGasOracleMaxAge is used when a keeper spec sets a gas oracle without a gasOracleMaxAge
+	defaultGasOracleMaxAge = 24 * time.Hour
+	// gasOracleTimeout bounds the gas oracle read, which holds up every check of the head
+	gasOracleTimeout = 5 * time.Second
 )
 
 // UpkeepExecuter fulfills Service and HeadTrackable interfaces
@@ -41,6 +53,18 @@ var (
 	},
 		[]string{"upkeepID"},
 	)
+	promCheckUpkeepRPCErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "keeper_check_upkeep_rpc_errors",
+		Help: "Number of failed checkUpkeep RPC calls, by error type (revert, timeout or error). Reverts telling that the upkeep is not needed are not counted",
+	},
+		[]string{"evmChainID", "errorType"},
+	)
+)
+
+const (
+	rpcErrorTypeRevert  = "revert"
+	rpcErrorTypeTimeout = "timeout"
+	rpcErrorTypeOther   = "error"
 )
 
 // UpkeepExecuter implements the logic to communicate with KeeperRegistry
@@ -89,6 +113,9 @@ func NewUpkeepExecuter(
 // Start starts the upkeep executer logic
 func (ex *UpkeepExecuter) Start(context.Context) error {
 	return ex.StartOnce("UpkeepExecuter", func() error {
+		if jitter := ex.config.KeeperCheckJitter(); jitter > maxCheckJitter {
+			ex.logger.Warnf("KEEPER_CHECK_JITTER of %s exceeds the maximum of %s and is capped to it", jitter, maxCheckJitter)
+		}
 		ex.wgDone.Add(2)
 		go ex.run()
 		latestHead, unsubscribeHeads := ex.headBroadcaster.Subscribe(ex)
@@ -184,30 +211,61 @@ func (ex *UpkeepExecuter) processActiveUpkeeps() {
 		ex.logger.Debugw("Fetched list of active upkeeps", "blockNum", head.Number, "active upkeeps list", fetchedUpkeepIDs)
 	}
 
+	// The gas oracle answer is the same for every upkeep, so it is read once per head
+	var oraclePrice *big.Int
+	if len(activeUpkeeps) > 0 && ex.config.KeeperCheckUpkeepGasPriceFeatureEnabled() && ex.job.KeeperSpec.GasOracleAddress != nil {
+		oraclePrice = ex.readGasOracle(head)
+	}
+
+	ex.runChecks(activeUpkeeps, ex.config.KeeperCheckJitter(), func(reg UpkeepRegistration, done func()) {
+		ex.execute(reg, head, oraclePrice, done)
+	})
+	ex.logger.Debugw("Finished checking upkeeps", "blockNum", head.Number)
+}
+
+// runChecks runs check for every upkeep, with at most executionQueueSize checks running at once, and
+// waits for all of them to finish. Each check is delayed by a random amount within jitterWindow to
+// spread the RPC load; the delay is waited out before a queue slot is taken so delayed checks don't
+// hold up the others.
+func (ex *UpkeepExecuter) runChecks(upkeeps []UpkeepRegistration, jitterWindow time.Duration, check func(reg UpkeepRegistration, done func())) {
 	wg := sync.WaitGroup{}
-	wg.Add(len(activeUpkeeps))
+	wg.Add(len(upkeeps))
 	done := func() {
 		<-ex.executionQueue
 		wg.Done()
 	}
-	for _, reg := range activeUpkeeps {
-		ex.executionQueue <- struct{}{}
-		go ex.execute(reg, head, done)
+	for _, reg := range upkeeps {
+		delay := checkJitter(jitterWindow)
+		if delay == 0 {
+			ex.executionQueue <- struct{}{}
+			go check(reg, done)
+			continue
+		}
+		go func(reg UpkeepRegistration) {
+			select {
+			case <-ex.chStop:
+				wg.Done()
+				return
+			case <-time.After(delay):
+			}
+			ex.executionQueue <- struct{}{}
+			check(reg, done)
+		}(reg)
 	}
 
 	wg.Wait()
-	ex.logger.Debugw("Finished checking upkeeps", "blockNum", head.Number)
 }
 
-// execute triggers the pipeline run
-func (ex *UpkeepExecuter) execute(upkeep UpkeepRegistration, head *evmtypes.Head, done func()) {
+// execute triggers the pipeline run. oraclePrice is the gas oracle answer for this head, or nil
+// if no gas oracle is configured or it could not be used.
+func (ex *UpkeepExecuter) execute(upkeep UpkeepRegistration, head *evmtypes.Head, oraclePrice *big.Int, done func()) {
 	defer done()
 
 	start := time.Now()
 	svcLogger := ex.logger.With("jobID", ex.job.ID, "blockNum", head.Number, "upkeepID", upkeep.UpkeepID)
 	svcLogger.Debugw("checking upkeep", "lastRunBlockHeight", upkeep.LastRunBlockHeight, "lastKeeperIndex", upkeep.LastKeeperIndex)
 
-	ctxService, cancel := utils.ContextFromChanWithDeadline(ex.chStop, time.Minute)
+	ctxService, cancel := utils.ContextFromChanWithDeadline(ex.chStop, checkDeadline)
 	defer cancel()
 
 	evmChainID := ""
@@ -215,9 +273,10 @@ func (ex *UpkeepExecuter) execute(upkeep UpkeepRegistration, head *evmtypes.Head
 		evmChainID = ex.job.KeeperSpec.EVMChainID.String()
 	}
 
-	var gasPrice, gasTipCap, gasFeeCap *big.Int
+	var gasPrice, gasTipCap, gasFeeCap, performGasPrice *big.Int
 	if ex.config.KeeperCheckUpkeepGasPriceFeatureEnabled() {
-		price, fee, err := ex.estimateGasPrice(upkeep)
+		performGasPrice = ex.oracleGasBid(upkeep, oraclePrice)
+		price, fee, err := ex.estimateGasPrice(upkeep, performGasPrice)
 		if err != nil {
 			svcLogger.Error(errors.Wrap(err, "estimating gas price"))
 			return
@@ -235,13 +294,15 @@ func (ex *UpkeepExecuter) execute(upkeep UpkeepRegistration, head *evmtypes.Head
 		}
 	}
 
-	vars := pipeline.NewVarsFrom(buildJobSpec(ex.job, upkeep, ex.orm.config, ex.config, gasPrice, gasTipCap, gasFeeCap, evmChainID))
+	vars := pipeline.NewVarsFrom(buildJobSpec(ex.job, upkeep, ex.orm.config, ex.config, gasPrice, gasTipCap, gasFeeCap, performGasPrice, evmChainID))
 
 	// DotDagSource in database is empty because all the Keeper pipeline runs make use of the same observation source
 	ex.job.PipelineSpec.DotDagSource = pipeline.KeepersObservationSource
 	run := pipeline.NewRun(*ex.job.PipelineSpec, vars)
 
-	if _, err := ex.pr.Run(ctxService, &run, svcLogger, true, nil); err != nil {
+	_, err := ex.pr.Run(ctxService, &run, svcLogger, true, nil)
+	recordCheckRPCErrors(run, evmChainID)
+	if err != nil {
 		svcLogger.Error(errors.Wrap(err, "failed executing run"))
 		return
 	}
@@ -261,7 +322,65 @@ func (ex *UpkeepExecuter) execute(upkeep UpkeepRegistration, head *evmtypes.Head
 	}
 }
 
-func (ex *UpkeepExecuter) estimateGasPrice(upkeep UpkeepRegistration) (gasPrice *big.Int, fee gas.DynamicFee, err error) {
+// checkUpkeepDotID is the DotID of the checkUpkeep eth call in pipeline.KeepersObservationSource
+const checkUpkeepDotID = "check_upkeep_tx"
+
+// upkeepNotNeededSelector is the selector of the UpkeepNotNeeded() error of registry 1.2 and later
+var upkeepNotNeededSelector = hexutil.Encode(Registry1_2ABI.Errors["UpkeepNotNeeded"].ID.Bytes()[:4])
+
+// recordCheckRPCErrors counts the failed checkUpkeep RPC calls of a keeper pipeline run. Errors raised before the
+// call was made and reverts telling that the upkeep is not needed are not RPC failures, so they are not counted.
+func recordCheckRPCErrors(run pipeline.Run, evmChainID string) {
+	for _, taskRun := range run.PipelineTaskRuns {
+		if taskRun.DotID != checkUpkeepDotID || !taskRun.Error.Valid {
+			continue
+		}
+		if !isRPCError(taskRun.Error.String) || isUpkeepNotNeeded(taskRun.Error.String) {
+			continue
+		}
+		promCheckUpkeepRPCErrors.WithLabelValues(evmChainID, checkRPCErrorType(taskRun.Error.String)).Inc()
+	}
+}
+
+// isRPCError reports whether errMsg is the stored error of an ethcall task whose eth_call request failed
+func isRPCError(errMsg string) bool {
+	return strings.HasSuffix(errMsg, pipeline.ErrCallFailed.Error())
+}
+
+// isUpkeepNotNeeded reports whether errMsg is the revert checkUpkeep answers with when the upkeep does not need
+// to be performed: "upkeep not needed" on registry 1.1, UpkeepNotNeeded() on registry 1.2 and later
+func isUpkeepNotNeeded(errMsg string) bool {
+	return strings.Contains(errMsg, "upkeep not needed") || strings.Contains(errMsg, upkeepNotNeededSelector)
+}
+
+func checkRPCErrorType(errMsg string) string {
+	switch {
+	case strings.Contains(errMsg, "execution reverted"):
+		return rpcErrorTypeRevert
+	case strings.Contains(errMsg, context.DeadlineExceeded.Error()):
+		return rpcErrorTypeTimeout
+	default:
+		return rpcErrorTypeOther
+	}
+}
+
+// oracleGasBid returns the gas price to bid for upkeep based on the gas oracle answer, capped at the
+// key specific max gas price, or nil if there is no oracle answer. It prices both the checkUpkeep call
+// and the performUpkeep transaction, so that keepers bid what the registry accounts for.
+func (ex *UpkeepExecuter) oracleGasBid(upkeep UpkeepRegistration, oraclePrice *big.Int) *big.Int {
+	if oraclePrice == nil {
+		return nil
+	}
+	price := addBuffer(oraclePrice, ex.config.KeeperGasPriceBufferPercent())
+	if keySpecificGasPriceWei := ex.config.KeySpecificMaxGasPriceWei(upkeep.Registry.FromAddress.Address()); keySpecificGasPriceWei != nil {
+		price = bigmath.Min(price, keySpecificGasPriceWei)
+	}
+	return price
+}
+
+// estimateGasPrice returns the gas price, or EIP-1559 fee, of the checkUpkeep call. oracleBid, if set,
+// is used instead of the estimator's gas price (or fee cap).
+func (ex *UpkeepExecuter) estimateGasPrice(upkeep UpkeepRegistration, oracleBid *big.Int) (gasPrice *big.Int, fee gas.DynamicFee, err error) {
 	var performTxData []byte
 	performTxData, err = Registry1_1ABI.Pack(
 		"performUpkeep", // performUpkeep is same across registry ABI versions
@@ -284,9 +403,83 @@ func (ex *UpkeepExecuter) estimateGasPrice(upkeep UpkeepRegistration) (gasPrice
 		return nil, fee, errors.Wrap(err, "unable to estimate gas")
 	}
 
+	if oracleBid == nil {
+		return gasPrice, fee, nil
+	}
+
+	// The registry prices upkeeps using its fast gas feed, so when a gas oracle is configured
+	// we bid with its value instead and only keep the estimator's result as a fallback.
+	if ex.config.EvmEIP1559DynamicFees() {
+		fee.FeeCap = oracleBid
+		if fee.TipCap.Cmp(fee.FeeCap) > 0 {
+			fee.TipCap = fee.FeeCap
+		}
+	} else {
+		gasPrice = oracleBid
+	}
+
 	return gasPrice, fee, nil
 }
 
+// readGasOracle returns the current gas oracle answer, or nil if it cannot be read or is stale,
+// in which case the gas estimator is used instead
+func (ex *UpkeepExecuter) readGasOracle(head *evmtypes.Head) *big.Int {
+	ctx, cancel := utils.ContextFromChanWithDeadline(ex.chStop, gasOracleTimeout)
+	defer cancel()
+
+	maxAge := ex.job.KeeperSpec.GasOracleMaxAge.Duration()
+	if maxAge <= 0 {
+		maxAge = defaultGasOracleMaxAge
+	}
+
+	price, err := ex.gasOraclePrice(ctx, ex.job.KeeperSpec.GasOracleAddress.Address(), maxAge)
+	if err != nil {
+		ex.logger.Warnw("unable to use gas oracle, falling back to gas estimator", "err", err, "blockNum", head.Number)
+		return nil
+	}
+	return price
+}
+
+// gasOraclePrice reads the latest answer of the AggregatorV3Interface compatible gas feed at addr.
+// Answers last updated more than maxAge ago are rejected.
+func (ex *UpkeepExecuter) gasOraclePrice(ctx context.Context, addr common.Address, maxAge time.Duration) (*big.Int, error) {
+	data, err := GasOracleABI.Pack("latestRoundData")
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to construct latestRoundData data")
+	}
+	result, err := ex.ethClient.CallContract(ctx, ethereum.CallMsg{To: &addr, Data: data}, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to call latestRoundData")
+	}
+	out, err := GasOracleABI.Unpack("latestRoundData", result)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to unpack latestRoundData result")
+	}
+	answer, ok := out[1].(*big.Int)
+	if !ok || answer.Sign() <= 0 {
+		return nil, errors.Errorf("invalid gas oracle answer: %v", out[1])
+	}
+	updatedAt, ok := out[3].(*big.Int)
+	if !ok || !updatedAt.IsInt64() {
+		return nil, errors.Errorf("invalid gas oracle updatedAt: %v", out[3])
+	}
+	if age := time.Since(time.Unix(updatedAt.Int64(), 0)); age > maxAge {
+		return nil, errors.Errorf("gas oracle answer is stale: last updated %s ago, max age is %s", age.Round(time.Second), maxAge)
+	}
+	return answer, nil
+}
+
+// checkJitter returns a random delay in [0, window), with window capped at maxCheckJitter
+func checkJitter(window time.Duration) time.Duration {
+	if window > maxCheckJitter {
+		window = maxCheckJitter
+	}
+	if window <= 0 {
+		return 0
+	}
+	return time.Duration(mrand.Int63n(int64(window)))
+}
+
 func addBuffer(val *big.Int, prct uint32) *big.Int {
 	return bigmath.Div(
 		bigmath.Mul(val, 100+prct),
@@ -313,8 +506,14 @@ func buildJobSpec(
 	gasPrice *big.Int,
 	gasTipCap *big.Int,
 	gasFeeCap *big.Int,
+	performGasPrice *big.Int,
 	chainID string,
 ) map[string]interface{} {
+	var stateOverrides map[string]interface{}
+	if jb.KeeperSpec != nil {
+		stateOverrides = jb.KeeperSpec.StateOverrides
+	}
+
 	return map[string]interface{}{
 		"jobSpec": map[string]interface{}{
 			"jobID":                 jb.ID,
@@ -325,10 +524,12 @@ func buildJobSpec(
 			"performUpkeepGasLimit": upkeep.ExecuteGas + ormConfig.KeeperRegistryPerformGasOverhead(),
 			"checkUpkeepGasLimit": exConfig.KeeperRegistryCheckGasOverhead() + upkeep.Registry.CheckGas +
 				exConfig.KeeperRegistryPerformGasOverhead() + upkeep.ExecuteGas,
-			"gasPrice":   gasPrice,
-			"gasTipCap":  gasTipCap,
-			"gasFeeCap":  gasFeeCap,
-			"evmChainID": chainID,
+			"gasPrice":        gasPrice,
+			"gasTipCap":       gasTipCap,
+			"gasFeeCap":       gasFeeCap,
+			"performGasPrice": performGasPrice,
+			"evmChainID":      chainID,
+			"stateOverrides":  stateOverrides,
 		},
 	}
 }
@@ -7,6 +7,7 @@ import (
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/onsi/gomega"
 	"github.com/stretchr/testify/assert"
@@ -34,6 +35,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/services/keeper"
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 	"github.com/smartcontractkit/chainlink/core/utils"
 	bigmath "github.com/smartcontractkit/chainlink/core/utils/big_math"
 )
@@ -309,6 +311,207 @@ func Test_UpkeepExecuter_PerformsUpkeep_Happy(t *testing.T) {
 		waitLastRunHeight(t, db, upkeep, 36)
 	})
 
+	t.Run("uses gas oracle price for check and perform if configured and falls back to estimator if it is unusable", func(t *testing.T) {
+		maxGasPriceWei := big.NewInt(100000000000000)
+		buffered := func(val *big.Int, prct uint32) *big.Int {
+			return bigmath.Div(bigmath.Mul(val, 100+prct), 100)
+		}
+		priceEqual := func(got, want *big.Int) bool {
+			if got == nil || want == nil {
+				return got == want
+			}
+			return got.Cmp(want) == 0
+		}
+
+		tests := []struct {
+			name         string
+			eip1559      bool
+			oracleAnswer *big.Int // nil makes latestRoundData revert
+			updatedAt    time.Time
+			// expected check call prices given the gas price and tip cap buffer percentages
+			wantGasPrice func(priceBuffer, tipBuffer uint32) *big.Int
+			wantFeeCap   func(priceBuffer, tipBuffer uint32) *big.Int
+			wantTipCap   func(priceBuffer, tipBuffer uint32) *big.Int
+			// expected performUpkeep transaction gas price, nil if it is left to the estimator
+			wantPerformGasPrice func(priceBuffer uint32) *big.Int
+		}{
+			{
+				name:                "oracle",
+				oracleAnswer:        assets.GWei(90),
+				updatedAt:           time.Now(),
+				wantGasPrice:        func(priceBuffer, _ uint32) *big.Int { return buffered(assets.GWei(90), priceBuffer) },
+				wantPerformGasPrice: func(priceBuffer uint32) *big.Int { return buffered(assets.GWei(90), priceBuffer) },
+			},
+			{
+				name:                "oracle is capped at the key specific max gas price",
+				oracleAnswer:        maxGasPriceWei,
+				updatedAt:           time.Now(),
+				wantGasPrice:        func(uint32, uint32) *big.Int { return maxGasPriceWei },
+				wantPerformGasPrice: func(uint32) *big.Int { return maxGasPriceWei },
+			},
+			{
+				name:         "oracle errors",
+				updatedAt:    time.Now(),
+				wantGasPrice: func(priceBuffer, _ uint32) *big.Int { return buffered(assets.GWei(60), priceBuffer) },
+			},
+			{
+				name:         "oracle is stale",
+				oracleAnswer: assets.GWei(90),
+				updatedAt:    time.Now().Add(-25 * time.Hour),
+				wantGasPrice: func(priceBuffer, _ uint32) *big.Int { return buffered(assets.GWei(60), priceBuffer) },
+			},
+			{
+				name:         "EIP1559 oracle with tip cap clamped to fee cap",
+				eip1559:      true,
+				oracleAnswer: assets.GWei(50),
+				updatedAt:    time.Now(),
+				wantFeeCap:   func(priceBuffer, _ uint32) *big.Int { return buffered(assets.GWei(50), priceBuffer) },
+				wantTipCap: func(priceBuffer, tipBuffer uint32) *big.Int {
+					return bigmath.Min(buffered(assets.GWei(60), tipBuffer), buffered(assets.GWei(50), priceBuffer))
+				},
+				wantPerformGasPrice: func(priceBuffer uint32) *big.Int { return buffered(assets.GWei(50), priceBuffer) },
+			},
+			{
+				name:       "EIP1559 oracle errors",
+				eip1559:    true,
+				updatedAt:  time.Now(),
+				wantFeeCap: func(uint32, uint32) *big.Int { return assets.GWei(60) },
+				wantTipCap: func(_, tipBuffer uint32) *big.Int { return buffered(assets.GWei(60), tipBuffer) },
+			},
+		}
+
+		for _, test := range tests {
+			test := test
+			t.Run(test.name, func(t *testing.T) {
+				db, config, ethMock, executer, registry, upkeep, job, jpv2, txm, _, _, _ := setup(t, mockEstimator(t))
+				config.Overrides.GlobalEvmEIP1559DynamicFees = null.BoolFrom(test.eip1559)
+
+				oracleAddress := ethkey.EIP55AddressFromAddress(testutils.NewAddress())
+				job.KeeperSpec.GasOracleAddress = &oracleAddress
+
+				oracleMock := cltest.NewContractMockReceiver(t, ethMock, keeper.GasOracleABI, oracleAddress.Address())
+				if test.oracleAnswer == nil {
+					oracleMock.MockRevertResponse("latestRoundData")
+				} else {
+					oracleMock.MockResponse("latestRoundData", big.NewInt(1), test.oracleAnswer, big.NewInt(0), big.NewInt(test.updatedAt.Unix()), big.NewInt(1))
+				}
+
+				priceBuffer, tipBuffer := config.KeeperGasPriceBufferPercent(), config.KeeperGasTipCapBufferPercent()
+				var wantGasPrice, wantFeeCap, wantTipCap *big.Int
+				if test.wantGasPrice != nil {
+					wantGasPrice = test.wantGasPrice(priceBuffer, tipBuffer)
+				}
+				if test.wantFeeCap != nil {
+					wantFeeCap = test.wantFeeCap(priceBuffer, tipBuffer)
+				}
+				if test.wantTipCap != nil {
+					wantTipCap = test.wantTipCap(priceBuffer, tipBuffer)
+				}
+				var wantPerformGasPrice *big.Int
+				if test.wantPerformGasPrice != nil {
+					wantPerformGasPrice = test.wantPerformGasPrice(priceBuffer)
+				}
+
+				gasLimit := upkeep.ExecuteGas + config.KeeperRegistryPerformGasOverhead()
+				ethTxCreated := cltest.NewAwaiter()
+				txm.On("CreateEthTransaction",
+					mock.MatchedBy(func(newTx txmgr.NewTx) bool {
+						var performGasPrice *big.Int
+						if newTx.Meta.GasPrice != nil {
+							performGasPrice = newTx.Meta.GasPrice.ToInt()
+						}
+						return newTx.GasLimit == gasLimit && priceEqual(performGasPrice, wantPerformGasPrice)
+					}),
+				).
+					Once().
+					Return(txmgr.EthTx{
+						ID: 1,
+					}, nil).
+					Run(func(mock.Arguments) { ethTxCreated.ItHappened() })
+
+				registryMock := cltest.NewContractMockReceiver(t, ethMock, keeper.Registry1_1ABI, registry.ContractAddress.Address())
+				registryMock.MockMatchedResponse(
+					"checkUpkeep",
+					func(callArgs ethereum.CallMsg) bool {
+						return priceEqual(callArgs.GasPrice, wantGasPrice) &&
+							priceEqual(callArgs.GasFeeCap, wantFeeCap) &&
+							priceEqual(callArgs.GasTipCap, wantTipCap) &&
+							callArgs.Gas == 650_000
+					},
+					checkUpkeepResponse,
+				)
+				registryMock.MockMatchedResponse(
+					"performUpkeep",
+					func(callArgs ethereum.CallMsg) bool { return true },
+					checkPerformResponse,
+				)
+
+				head := newHead()
+				executer.OnNewLongestChain(testutils.Context(t), &head)
+				ethTxCreated.AwaitOrFail(t)
+				runs := cltest.WaitForPipelineComplete(t, 0, job.ID, 1, 8, jpv2.Jrm, time.Second, 100*time.Millisecond)
+				require.Len(t, runs, 1)
+				assert.False(t, runs[0].HasErrors())
+				waitLastRunHeight(t, db, upkeep, 20)
+			})
+		}
+	})
+
+	t.Run("passes state overrides to checkUpkeep", func(t *testing.T) {
+		db, config, ethMock, executer, registry, upkeep, job, jpv2, txm, _, _, _ := setup(t, mockEstimator(t))
+
+		stateOverrides := map[string]interface{}{
+			job.KeeperSpec.FromAddress.Hex(): map[string]interface{}{"balance": "0xde0b6b3a7640000"},
+		}
+		job.KeeperSpec.StateOverrides = stateOverrides
+
+		checkUpkeepResult, err := keeper.Registry1_1ABI.Methods["checkUpkeep"].Outputs.Pack(
+			checkUpkeepResponse.PerformData,
+			checkUpkeepResponse.MaxLinkPayment,
+			checkUpkeepResponse.GasLimit,
+			checkUpkeepResponse.GasWei,
+			checkUpkeepResponse.LinkEth,
+		)
+		require.NoError(t, err)
+		ethMock.On("CallContext", mock.Anything, mock.Anything, "eth_call",
+			mock.MatchedBy(func(callArg map[string]interface{}) bool {
+				to, ok := callArg["to"].(*common.Address)
+				return ok && *to == registry.ContractAddress.Address()
+			}),
+			"latest", stateOverrides,
+		).
+			Run(func(args mock.Arguments) {
+				*args.Get(1).(*hexutil.Bytes) = checkUpkeepResult
+			}).
+			Return(nil).Once()
+
+		gasLimit := upkeep.ExecuteGas + config.KeeperRegistryPerformGasOverhead()
+		ethTxCreated := cltest.NewAwaiter()
+		txm.On("CreateEthTransaction",
+			mock.MatchedBy(func(newTx txmgr.NewTx) bool { return newTx.GasLimit == gasLimit }),
+		).
+			Once().
+			Return(txmgr.EthTx{
+				ID: 1,
+			}, nil).
+			Run(func(mock.Arguments) { ethTxCreated.ItHappened() })
+
+		registryMock := cltest.NewContractMockReceiver(t, ethMock, keeper.Registry1_1ABI, registry.ContractAddress.Address())
+		registryMock.MockMatchedResponse(
+			"performUpkeep",
+			func(callArgs ethereum.CallMsg) bool { return true },
+			checkPerformResponse,
+		)
+
+		head := newHead()
+		executer.OnNewLongestChain(testutils.Context(t), &head)
+		ethTxCreated.AwaitOrFail(t)
+		runs := cltest.WaitForPipelineComplete(t, 0, job.ID, 1, 8, jpv2.Jrm, time.Second, 100*time.Millisecond)
+		require.Len(t, runs, 1)
+		assert.False(t, runs[0].HasErrors())
+		waitLastRunHeight(t, db, upkeep, 20)
+	})
+
 	t.Run("verify key specific max gas price is passed into estimator for EIP1559 and non-EIP1559 chains", func(t *testing.T) {
 		runTest := func(t *testing.T, estimator *gasmocks.Estimator, eip1559 bool) {
 			db, config, ethMock, executer, registry, upkeep, job, jpv2, txm, _, _, _ := setup(t, estimator)
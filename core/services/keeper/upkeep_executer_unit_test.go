@@ -4,13 +4,18 @@ import (
 	"fmt"
 	"math/big"
 	"testing"
+	"time"
 
+	promtestutil "github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
@@ -45,7 +50,10 @@ func (_m *registryGasCheckMock) KeeperRegistryPerformGasOverhead() uint32 {
 }
 
 func TestBuildJobSpec(t *testing.T) {
-	jb := job.Job{ID: 10}
+	stateOverrides := map[string]interface{}{
+		testutils.NewAddress().Hex(): map[string]interface{}{"balance": "0xde0b6b3a7640000"},
+	}
+	jb := job.Job{ID: 10, KeeperSpec: &job.KeeperSpec{StateOverrides: stateOverrides}}
 	from := ethkey.EIP55Address(testutils.NewAddress().Hex())
 	contract := ethkey.EIP55Address(testutils.NewAddress().Hex())
 	upkeepID := utils.NewBigI(4)
@@ -61,6 +69,7 @@ func TestBuildJobSpec(t *testing.T) {
 	gasPrice := big.NewInt(24)
 	gasTipCap := big.NewInt(48)
 	gasFeeCap := big.NewInt(72)
+	performGasPrice := big.NewInt(96)
 	chainID := "250"
 
 	m := &registryGasCheckMock{}
@@ -69,7 +78,7 @@ func TestBuildJobSpec(t *testing.T) {
 	m.On("KeeperRegistryPerformGasOverhead").Return(uint32(9)).Times(2)
 	m.On("KeeperRegistryCheckGasOverhead").Return(uint32(6)).Times(1)
 
-	spec := buildJobSpec(jb, upkeep, m, m, gasPrice, gasTipCap, gasFeeCap, chainID)
+	spec := buildJobSpec(jb, upkeep, m, m, gasPrice, gasTipCap, gasFeeCap, performGasPrice, chainID)
 
 	expected := map[string]interface{}{
 		"jobSpec": map[string]interface{}{
@@ -83,9 +92,97 @@ func TestBuildJobSpec(t *testing.T) {
 			"gasPrice":              gasPrice,
 			"gasTipCap":             gasTipCap,
 			"gasFeeCap":             gasFeeCap,
+			"performGasPrice":       performGasPrice,
 			"evmChainID":            "250",
+			"stateOverrides":        stateOverrides,
 		},
 	}
 
 	require.Equal(t, expected, spec)
 }
+
+func TestCheckRPCErrorType(t *testing.T) {
+	tests := []struct {
+		name   string
+		errMsg string
+		want   string
+	}{
+		{"revert", "upkeep not needed: execution reverted", rpcErrorTypeRevert},
+		{"timeout", "context deadline exceeded", rpcErrorTypeTimeout},
+		{"other", "connection refused", rpcErrorTypeOther},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.want, checkRPCErrorType(test.errMsg))
+		})
+	}
+}
+
+func TestRecordCheckRPCErrors(t *testing.T) {
+	evmChainID := "1337"
+	callFailed := func(msg string) null.String {
+		return null.StringFrom(fmt.Sprintf("%s: %s", msg, pipeline.ErrCallFailed))
+	}
+	run := pipeline.Run{
+		PipelineTaskRuns: []pipeline.TaskRun{
+			{DotID: "encode_check_upkeep_tx", Type: pipeline.TaskTypeETHABIEncode, Error: null.StringFrom("bad abi")},
+			{DotID: checkUpkeepDotID, Type: pipeline.TaskTypeETHCall, Error: callFailed("upkeep not needed: execution reverted")},
+			{DotID: checkUpkeepDotID, Type: pipeline.TaskTypeETHCall, Error: callFailed(upkeepNotNeededSelector + ": execution reverted")},
+			{DotID: checkUpkeepDotID, Type: pipeline.TaskTypeETHCall, Error: callFailed("upkeep cancelled: execution reverted")},
+			{DotID: checkUpkeepDotID, Type: pipeline.TaskTypeETHCall, Error: callFailed("context deadline exceeded")},
+			{DotID: checkUpkeepDotID, Type: pipeline.TaskTypeETHCall, Error: callFailed("connection refused")},
+			{DotID: checkUpkeepDotID, Type: pipeline.TaskTypeETHCall, Error: null.StringFrom("contract: key contractAddress (segment 1 in keypath jobSpec.contractAddress): keypath not found")},
+			{DotID: checkUpkeepDotID, Type: pipeline.TaskTypeETHCall, Error: null.StringFrom("data param must not be empty: bad input for task")},
+			{DotID: checkUpkeepDotID, Type: pipeline.TaskTypeETHCall, Error: null.StringFrom("chain not found with id 999")},
+			{DotID: checkUpkeepDotID, Type: pipeline.TaskTypeETHCall},
+			{DotID: "simulate_perform_upkeep_tx", Type: pipeline.TaskTypeETHCall, Error: callFailed("execution reverted")},
+		},
+	}
+
+	recordCheckRPCErrors(run, evmChainID)
+
+	require.Equal(t, float64(1), promtestutil.ToFloat64(promCheckUpkeepRPCErrors.WithLabelValues(evmChainID, rpcErrorTypeRevert)))
+	require.Equal(t, float64(1), promtestutil.ToFloat64(promCheckUpkeepRPCErrors.WithLabelValues(evmChainID, rpcErrorTypeTimeout)))
+	require.Equal(t, float64(1), promtestutil.ToFloat64(promCheckUpkeepRPCErrors.WithLabelValues(evmChainID, rpcErrorTypeOther)))
+}
+
+func TestUpkeepNotNeededSelector(t *testing.T) {
+	require.Equal(t, "0x865676e3", upkeepNotNeededSelector)
+}
+
+func TestCheckJitter(t *testing.T) {
+	require.Zero(t, checkJitter(0))
+	require.Zero(t, checkJitter(-time.Second))
+
+	for i := 0; i < 100; i++ {
+		delay := checkJitter(time.Second)
+		require.GreaterOrEqual(t, delay, time.Duration(0))
+		require.Less(t, delay, time.Second)
+
+		delay = checkJitter(time.Hour)
+		require.Less(t, delay, maxCheckJitter)
+	}
+	require.Less(t, maxCheckJitter, checkDeadline)
+}
+
+func TestUpkeepExecuter_RunChecks(t *testing.T) {
+	ex := &UpkeepExecuter{
+		chStop:         make(chan struct{}),
+		executionQueue: make(chan struct{}, executionQueueSize),
+	}
+	upkeeps := make([]UpkeepRegistration, 20*executionQueueSize)
+	jitterWindow := 200 * time.Millisecond
+
+	var checked atomic.Int32
+	start := time.Now()
+	ex.runChecks(upkeeps, jitterWindow, func(_ UpkeepRegistration, done func()) {
+		defer done()
+		checked.Inc()
+	})
+	elapsed := time.Since(start)
+
+	require.Equal(t, int32(len(upkeeps)), checked.Load())
+	// Delays are waited out before taking a queue slot, so all checks finish within about one jitter
+	// window rather than one window per executionQueueSize checks
+	require.Less(t, elapsed, 2*jitterWindow)
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/pkg/errors"
 	uuid "github.com/satori/go.uuid"
 	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 )
 
 // ValidatedKeeperSpec analyses the tomlString passed as parameter and
@@ -42,5 +43,9 @@ func ValidatedKeeperSpec(tomlString string) (job.Job, error) {
 		return j, errors.New("There should be no 'observationSource' parameter included in the toml")
 	}
 
+	if err := pipeline.ValidateStateOverrides(spec.StateOverrides); err != nil {
+		return j, errors.Wrap(err, "invalid stateOverrides")
+	}
+
 	return j, nil
 }
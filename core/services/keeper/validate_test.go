@@ -20,6 +20,10 @@ func TestValidatedKeeperSpec(t *testing.T) {
 		fromAddr     string
 		createdAt    time.Time
 		updatedAt    time.Time
+
+		gasOracleAddr   string
+		gasOracleMaxAge time.Duration
+		stateOverrides  map[string]interface{}
 	}
 
 	tests := []struct {
@@ -71,6 +75,58 @@ func TestValidatedKeeperSpec(t *testing.T) {
 			wantErr: false,
 		},
 
+		{
+			name: "valid job spec with gas oracle",
+			args: args{
+				tomlString: `
+						    type                        = "keeper"
+						    name                        = "example keeper spec"
+						    contractAddress             = "0x9E40733cC9df84636505f4e6Db28DCa0dC5D1bba"
+						    fromAddress                 = "0xa8037A20989AFcBC51798de9762b351D63ff462e"
+						    gasOracleAddress            = "0x169E633A2D1E6c10dD91238Ba11c4A708dfEF37C"
+						    gasOracleMaxAge             = "1h"
+						    evmChainID                  = 4
+						    externalJobID               =  "123e4567-e89b-12d3-a456-426655440002"
+					    `,
+			},
+			want: want{
+				id:              0,
+				contractAddr:    "0x9E40733cC9df84636505f4e6Db28DCa0dC5D1bba",
+				fromAddr:        "0xa8037A20989AFcBC51798de9762b351D63ff462e",
+				createdAt:       time.Time{},
+				updatedAt:       time.Time{},
+				gasOracleAddr:   "0x169E633A2D1E6c10dD91238Ba11c4A708dfEF37C",
+				gasOracleMaxAge: time.Hour,
+			},
+			wantErr: false,
+		},
+
+		{
+			name: "valid job spec with state overrides",
+			args: args{
+				tomlString: `
+						    type                        = "keeper"
+						    name                        = "example keeper spec"
+						    contractAddress             = "0x9E40733cC9df84636505f4e6Db28DCa0dC5D1bba"
+						    fromAddress                 = "0xa8037A20989AFcBC51798de9762b351D63ff462e"
+						    evmChainID                  = 4
+						    externalJobID               =  "123e4567-e89b-12d3-a456-426655440002"
+						    stateOverrides              = { "0xa8037A20989AFcBC51798de9762b351D63ff462e" = { balance = "0xde0b6b3a7640000" } }
+					    `,
+			},
+			want: want{
+				id:           0,
+				contractAddr: "0x9E40733cC9df84636505f4e6Db28DCa0dC5D1bba",
+				fromAddr:     "0xa8037A20989AFcBC51798de9762b351D63ff462e",
+				createdAt:    time.Time{},
+				updatedAt:    time.Time{},
+				stateOverrides: map[string]interface{}{
+					"0xa8037A20989AFcBC51798de9762b351D63ff462e": map[string]interface{}{"balance": "0xde0b6b3a7640000"},
+				},
+			},
+			wantErr: false,
+		},
+
 		{
 			name: "invalid job spec because of type",
 			args: args{
@@ -131,6 +187,23 @@ func TestValidatedKeeperSpec(t *testing.T) {
 			wantErr: true,
 		},
 
+		{
+			name: "invalid job spec because state overrides are malformed",
+			args: args{
+				tomlString: `
+						    type                        = "keeper"
+						    name                        = "example keeper spec"
+						    contractAddress             = "0x9E40733cC9df84636505f4e6Db28DCa0dC5D1bba"
+						    fromAddress                 = "0xa8037A20989AFcBC51798de9762b351D63ff462e"
+						    evmChainID                  = 4
+						    externalJobID               =  "123e4567-e89b-12d3-a456-426655440002"
+						    stateOverrides              = { "0xa8037A20989AFcBC51798de9762b351D63ff462e" = { funds = "0xde0b6b3a7640000" } }
+					    `,
+			},
+			want:    want{},
+			wantErr: true,
+		},
+
 		{
 			name: "invalid job spec because observation source is passed as parameter (uppercase)",
 			args: args{
@@ -190,6 +263,18 @@ func TestValidatedKeeperSpec(t *testing.T) {
 			require.Equal(t, tt.want.fromAddr, got.KeeperSpec.FromAddress.Hex())
 			require.Equal(t, tt.want.createdAt, got.KeeperSpec.CreatedAt)
 			require.Equal(t, tt.want.updatedAt, got.KeeperSpec.UpdatedAt)
+			if tt.want.gasOracleAddr == "" {
+				require.Nil(t, got.KeeperSpec.GasOracleAddress)
+			} else {
+				require.NotNil(t, got.KeeperSpec.GasOracleAddress)
+				require.Equal(t, tt.want.gasOracleAddr, got.KeeperSpec.GasOracleAddress.Hex())
+			}
+			require.Equal(t, tt.want.gasOracleMaxAge, got.KeeperSpec.GasOracleMaxAge.Duration())
+			if tt.want.stateOverrides == nil {
+				require.Empty(t, got.KeeperSpec.StateOverrides)
+			} else {
+				require.Equal(t, tt.want.stateOverrides, map[string]interface{}(got.KeeperSpec.StateOverrides))
+			}
 		})
 	}
 
@@ -29,6 +29,7 @@ const KeepersObservationSource = `
                               gasPrice="$(jobSpec.gasPrice)"
                               gasTipCap="$(jobSpec.gasTipCap)"
                               gasFeeCap="$(jobSpec.gasFeeCap)"
+                              stateOverrides="$(jobSpec.stateOverrides)"
                               data="$(encode_check_upkeep_tx)"]
     decode_check_upkeep_tx   [type=ethabidecode
                               abi="bytes memory performData, uint256 maxLinkPayment, uint256 gasLimit, uint256 adjustedGasWei, uint256 linkEth"]
@@ -54,6 +55,7 @@ const KeepersObservationSource = `
                               evmChainID="$(jobSpec.evmChainID)"
                               data="$(encode_perform_upkeep_tx)"
                               gasLimit="$(jobSpec.performUpkeepGasLimit)"
+                              gasPrice="$(jobSpec.performGasPrice)"
                               txMeta="{\"jobID\":$(jobSpec.jobID),\"upkeepID\":$(jobSpec.prettyID)}"]
     encode_check_upkeep_tx -> check_upkeep_tx -> decode_check_upkeep_tx -> encode_perform_upkeep_tx -> simulate_perform_upkeep_tx -> decode_check_perform_tx -> check_success -> perform_upkeep_tx
 `
@@ -2,10 +2,12 @@ package pipeline
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
@@ -32,6 +34,7 @@ type ETHCallTask struct {
 	GasFeeCap           string `json:"gasFeeCap"`
 	ExtractRevertReason bool   `json:"extractRevertReason"`
 	EVMChainID          string `json:"evmChainID" mapstructure:"evmChainID"`
+	StateOverrides      string `json:"stateOverrides"`
 
 	specGasLimit *uint32
 	chainSet     evm.ChainSet
@@ -41,6 +44,10 @@ type ETHCallTask struct {
 
 var _ Task = (*ETHCallTask)(nil)
 
+// ErrCallFailed is wrapped by the errors of ethcall tasks whose eth_call request failed, as opposed to
+// those that failed before making it, e.g. on their params
+var ErrCallFailed = errors.New("eth_call failed")
+
 var (
 	promETHCallTime = promauto.NewGaugeVec(prometheus.GaugeOpts{
 		Name: "pipeline_task_eth_call_execution_time",
@@ -61,14 +68,15 @@ func (t *ETHCallTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, in
 	}
 
 	var (
-		contractAddr AddressParam
-		from         AddressParam
-		data         BytesParam
-		gas          Uint64Param
-		gasPrice     MaybeBigIntParam
-		gasTipCap    MaybeBigIntParam
-		gasFeeCap    MaybeBigIntParam
-		chainID      StringParam
+		contractAddr   AddressParam
+		from           AddressParam
+		data           BytesParam
+		gas            Uint64Param
+		gasPrice       MaybeBigIntParam
+		gasTipCap      MaybeBigIntParam
+		gasFeeCap      MaybeBigIntParam
+		chainID        StringParam
+		stateOverrides MapParam
 	)
 	err = multierr.Combine(
 		errors.Wrap(ResolveParam(&contractAddr, From(VarExpr(t.Contract, vars), NonemptyString(t.Contract))), "contract"),
@@ -79,11 +87,14 @@ func (t *ETHCallTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, in
 		errors.Wrap(ResolveParam(&gasTipCap, From(VarExpr(t.GasTipCap, vars), t.GasTipCap)), "gasTipCap"),
 		errors.Wrap(ResolveParam(&gasFeeCap, From(VarExpr(t.GasFeeCap, vars), t.GasFeeCap)), "gasFeeCap"),
 		errors.Wrap(ResolveParam(&chainID, From(VarExpr(t.EVMChainID, vars), NonemptyString(t.EVMChainID), "")), "evmChainID"),
+		errors.Wrap(ResolveParam(&stateOverrides, From(VarExpr(t.StateOverrides, vars), JSONWithVarExprs(t.StateOverrides, vars, false), nil)), "stateOverrides"),
 	)
 	if err != nil {
 		return Result{Error: err}, runInfo
 	} else if len(data) == 0 {
 		return Result{Error: errors.Wrapf(ErrBadInput, "data param must not be empty")}, runInfo
+	} else if err = ValidateStateOverrides(stateOverrides); err != nil {
+		return Result{Error: errors.Wrapf(ErrBadInput, "stateOverrides: %v", err)}, runInfo
 	}
 
 	chain, err := getChainByString(t.chainSet, string(chainID))
@@ -113,14 +124,19 @@ func (t *ETHCallTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, in
 		With("gasFeeCap", call.GasFeeCap)
 
 	start := time.Now()
-	resp, err := chain.Client().CallContract(ctx, call, nil)
+	var resp []byte
+	if len(stateOverrides) > 0 {
+		resp, err = t.callWithStateOverrides(ctx, chain.Client(), call, stateOverrides, lggr)
+	} else {
+		resp, err = chain.Client().CallContract(ctx, call, nil)
+	}
 	elapsed := time.Since(start)
 	if err != nil {
 		if t.ExtractRevertReason {
 			err = t.retrieveRevertReason(err, lggr)
 		}
 
-		return Result{Error: err}, retryableRunInfo()
+		return Result{Error: errors.Wrapf(ErrCallFailed, "%v", err)}, retryableRunInfo()
 	}
 
 	promETHCallTime.WithLabelValues(t.DotID()).Set(float64(elapsed))
@@ -128,12 +144,96 @@ func (t *ETHCallTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, in
 	return Result{Value: resp}, runInfo
 }
 
+// callWithStateOverrides performs an eth_call against the latest block with the given state override set
+// (address => {balance, nonce, code, state, stateDiff}). Not every RPC supports the override argument, so if
+// the node rejects it the call is retried without overrides rather than failing the task.
+func (t *ETHCallTask) callWithStateOverrides(ctx context.Context, client evmclient.Client, call ethereum.CallMsg, overrides MapParam, lggr logger.Logger) ([]byte, error) {
+	var resp hexutil.Bytes
+	err := client.CallContext(ctx, &resp, "eth_call", toCallArg(call), "latest", map[string]interface{}(overrides))
+	if err != nil && isStateOverrideUnsupported(err) {
+		lggr.Warnw("RPC does not support eth_call state overrides, ignoring them", "err", err)
+		return client.CallContract(ctx, call, nil)
+	}
+	return resp, err
+}
+
+func toCallArg(msg ethereum.CallMsg) interface{} {
+	arg := map[string]interface{}{
+		"from": msg.From,
+		"to":   msg.To,
+	}
+	if len(msg.Data) > 0 {
+		arg["data"] = hexutil.Bytes(msg.Data)
+	}
+	if msg.Gas != 0 {
+		arg["gas"] = hexutil.Uint64(msg.Gas)
+	}
+	if msg.GasPrice != nil {
+		arg["gasPrice"] = (*hexutil.Big)(msg.GasPrice)
+	}
+	if msg.GasFeeCap != nil {
+		arg["maxFeePerGas"] = (*hexutil.Big)(msg.GasFeeCap)
+	}
+	if msg.GasTipCap != nil {
+		arg["maxPriorityFeePerGas"] = (*hexutil.Big)(msg.GasTipCap)
+	}
+	return arg
+}
+
+var stateOverrideFields = map[string]struct{}{
+	"balance":   {},
+	"nonce":     {},
+	"code":      {},
+	"state":     {},
+	"stateDiff": {},
+}
+
+// ValidateStateOverrides checks that overrides is shaped like an eth_call state override set, so that
+// malformed input fails the task instead of being sent to the RPC
+func ValidateStateOverrides(overrides map[string]interface{}) error {
+	for addr, override := range overrides {
+		if !common.IsHexAddress(addr) {
+			return errors.Errorf("%q is not an address", addr)
+		}
+		fields, ok := override.(map[string]interface{})
+		if !ok {
+			return errors.Errorf("override for %s must be an object, got %T", addr, override)
+		}
+		for field := range fields {
+			if _, ok := stateOverrideFields[field]; !ok {
+				return errors.Errorf("unknown field %q in override for %s", field, addr)
+			}
+		}
+		_, hasState := fields["state"]
+		_, hasStateDiff := fields["stateDiff"]
+		if hasState && hasStateDiff {
+			return errors.Errorf("override for %s cannot set both state and stateDiff", addr)
+		}
+	}
+	return nil
+}
+
+// isStateOverrideUnsupported reports whether the RPC rejected the override argument itself. Nodes that do support
+// overrides return the same invalid params error code (-32602) for a malformed override set, so only the argument
+// count error is treated as unsupported.
+func isStateOverrideUnsupported(err error) bool {
+	return strings.Contains(err.Error(), "too many arguments")
+}
+
 func (t *ETHCallTask) retrieveRevertReason(baseErr error, lggr logger.Logger) error {
 	reason, err := evmclient.ExtractRevertReasonFromRPCError(baseErr)
 	if err != nil {
 		lggr.Warnw("failed to extract revert reason", "baseErr", baseErr, "error", err)
 		return baseErr
 	}
+	if reason == "" {
+		// Custom errors have no revert reason, keep their data (the error selector and args) instead
+		data, err := evmclient.ExtractRevertDataFromRPCError(baseErr)
+		if err != nil {
+			return baseErr
+		}
+		reason = data
+	}
 
 	return errors.Wrap(baseErr, reason)
 }
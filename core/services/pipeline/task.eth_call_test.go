@@ -6,6 +6,7 @@ import (
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -13,6 +14,7 @@ import (
 	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/core/chains/evm"
+	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
 	evmmocks "github.com/smartcontractkit/chainlink/core/chains/evm/mocks"
 	txmmocks "github.com/smartcontractkit/chainlink/core/chains/evm/txmgr/mocks"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
@@ -24,6 +26,7 @@ import (
 	keystoremocks "github.com/smartcontractkit/chainlink/core/services/keystore/mocks"
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 	pipelinemocks "github.com/smartcontractkit/chainlink/core/services/pipeline/mocks"
+	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
 func TestETHCallTask(t *testing.T) {
@@ -281,3 +284,173 @@ func TestETHCallTask(t *testing.T) {
 		})
 	}
 }
+
+func TestETHCallTask_StateOverrides(t *testing.T) {
+	t.Parallel()
+
+	contractAddr := common.HexToAddress("0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF")
+	overrides := map[string]interface{}{
+		"0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF": map[string]interface{}{"balance": "0xde0b6b3a7640000"},
+	}
+	vars := pipeline.NewVarsFrom(map[string]interface{}{
+		"foo":       []byte("foo bar"),
+		"overrides": overrides,
+	})
+
+	newTask := func(t *testing.T, ethClient *evmmocks.Client) pipeline.ETHCallTask {
+		task := pipeline.ETHCallTask{
+			BaseTask:       pipeline.NewBaseTask(0, "ethcall", nil, nil, 0),
+			Contract:       contractAddr.Hex(),
+			Data:           "$(foo)",
+			Gas:            "100000",
+			StateOverrides: "$(overrides)",
+		}
+		cfg := configtest.NewTestGeneralConfig(t)
+		cc := cltest.NewChainSetMockWithOneChain(t, ethClient, evmtest.NewChainScopedConfig(t, cfg))
+		task.HelperSetDependencies(cc, cfg, nil, pipeline.DirectRequestJobType)
+		return task
+	}
+
+	t.Run("passes overrides to eth_call", func(t *testing.T) {
+		ethClient := evmmocks.NewClient(t)
+		ethClient.On("CallContext", mock.Anything, mock.Anything, "eth_call", mock.Anything, "latest", overrides).
+			Run(func(args mock.Arguments) {
+				*args.Get(1).(*hexutil.Bytes) = []byte("baz quux")
+			}).
+			Return(nil).Once()
+		task := newTask(t, ethClient)
+
+		result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), vars, nil)
+		assert.False(t, runInfo.IsRetryable)
+		require.NoError(t, result.Error)
+		require.Equal(t, []byte("baz quux"), result.Value)
+	})
+
+	t.Run("ignores overrides if the RPC does not support them", func(t *testing.T) {
+		ethClient := evmmocks.NewClient(t)
+		ethClient.On("CallContext", mock.Anything, mock.Anything, "eth_call", mock.Anything, "latest", overrides).
+			Return(errors.New("too many arguments, want at most 2")).Once()
+		ethClient.On("CallContract", mock.Anything, ethereum.CallMsg{To: &contractAddr, Gas: 100_000, Data: []byte("foo bar")}, (*big.Int)(nil)).
+			Return([]byte("baz quux"), nil).Once()
+		task := newTask(t, ethClient)
+
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), vars, nil)
+		require.NoError(t, result.Error)
+		require.Equal(t, []byte("baz quux"), result.Value)
+	})
+
+	t.Run("ignores overrides if the RPC rejects the argument with invalid params", func(t *testing.T) {
+		ethClient := evmmocks.NewClient(t)
+		ethClient.On("CallContext", mock.Anything, mock.Anything, "eth_call", mock.Anything, "latest", overrides).
+			Return(stateOverrideRPCError{code: -32602, msg: "too many arguments, want at most 2"}).Once()
+		ethClient.On("CallContract", mock.Anything, ethereum.CallMsg{To: &contractAddr, Gas: 100_000, Data: []byte("foo bar")}, (*big.Int)(nil)).
+			Return([]byte("baz quux"), nil).Once()
+		task := newTask(t, ethClient)
+
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), vars, nil)
+		require.NoError(t, result.Error)
+		require.Equal(t, []byte("baz quux"), result.Value)
+	})
+
+	t.Run("does not ignore overrides on other invalid params errors", func(t *testing.T) {
+		ethClient := evmmocks.NewClient(t)
+		ethClient.On("CallContext", mock.Anything, mock.Anything, "eth_call", mock.Anything, "latest", overrides).
+			Return(stateOverrideRPCError{code: -32602, msg: "invalid argument 2: json: cannot unmarshal hex string without 0x prefix"}).Once()
+		task := newTask(t, ethClient)
+
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), vars, nil)
+		require.Error(t, result.Error)
+		require.Contains(t, result.Error.Error(), "invalid argument 2")
+	})
+
+	t.Run("returns other errors", func(t *testing.T) {
+		ethClient := evmmocks.NewClient(t)
+		ethClient.On("CallContext", mock.Anything, mock.Anything, "eth_call", mock.Anything, "latest", overrides).
+			Return(errors.New("execution reverted")).Once()
+		task := newTask(t, ethClient)
+
+		result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), vars, nil)
+		require.ErrorIs(t, result.Error, pipeline.ErrCallFailed)
+		assert.True(t, runInfo.IsRetryable)
+	})
+
+	t.Run("rejects malformed overrides without calling the RPC", func(t *testing.T) {
+		tests := []struct {
+			name      string
+			overrides map[string]interface{}
+		}{
+			{"key is not an address", map[string]interface{}{"foo": map[string]interface{}{"balance": "0x1"}}},
+			{"override is not an object", map[string]interface{}{contractAddr.Hex(): "0x1"}},
+			{"unknown field", map[string]interface{}{contractAddr.Hex(): map[string]interface{}{"balanse": "0x1"}}},
+			{"both state and stateDiff", map[string]interface{}{contractAddr.Hex(): map[string]interface{}{
+				"state":     map[string]interface{}{},
+				"stateDiff": map[string]interface{}{},
+			}}},
+		}
+
+		for _, test := range tests {
+			test := test
+			t.Run(test.name, func(t *testing.T) {
+				task := newTask(t, evmmocks.NewClient(t))
+				vars := pipeline.NewVarsFrom(map[string]interface{}{
+					"foo":       []byte("foo bar"),
+					"overrides": test.overrides,
+				})
+
+				result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), vars, nil)
+				require.ErrorIs(t, result.Error, pipeline.ErrBadInput)
+				require.NotErrorIs(t, result.Error, pipeline.ErrCallFailed)
+				assert.False(t, runInfo.IsRetryable)
+			})
+		}
+	})
+}
+
+func TestETHCallTask_Reverts(t *testing.T) {
+	t.Parallel()
+
+	contractAddr := common.HexToAddress("0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF")
+	vars := pipeline.NewVarsFrom(map[string]interface{}{"foo": []byte("foo bar")})
+	message := "important revert reason"
+
+	tests := []struct {
+		name     string
+		data     string
+		expected string
+	}{
+		{"revert reason", "0x12345678" + utils.RemoveHexPrefix(hexutil.Encode([]byte(message))), message + ": execution reverted: " + pipeline.ErrCallFailed.Error()},
+		{"custom error", "0x12345678", "0x12345678: execution reverted: " + pipeline.ErrCallFailed.Error()},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			ethClient := evmmocks.NewClient(t)
+			ethClient.On("CallContract", mock.Anything, ethereum.CallMsg{To: &contractAddr, Gas: 100_000, Data: []byte("foo bar")}, (*big.Int)(nil)).
+				Return(nil, &evmclient.JsonError{Code: 3, Data: test.data, Message: "execution reverted"}).Once()
+
+			task := pipeline.ETHCallTask{
+				BaseTask:            pipeline.NewBaseTask(0, "ethcall", nil, nil, 0),
+				Contract:            contractAddr.Hex(),
+				Data:                "$(foo)",
+				Gas:                 "100000",
+				ExtractRevertReason: true,
+			}
+			cfg := configtest.NewTestGeneralConfig(t)
+			cc := cltest.NewChainSetMockWithOneChain(t, ethClient, evmtest.NewChainScopedConfig(t, cfg))
+			task.HelperSetDependencies(cc, cfg, nil, pipeline.DirectRequestJobType)
+
+			result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), vars, nil)
+			require.ErrorIs(t, result.Error, pipeline.ErrCallFailed)
+			require.EqualError(t, result.Error, test.expected)
+			assert.True(t, runInfo.IsRetryable)
+		})
+	}
+}
+
+type stateOverrideRPCError struct {
+	code int
+	msg  string
+}
+
+func (e stateOverrideRPCError) Error() string  { return e.msg }
+func (e stateOverrideRPCError) ErrorCode() int { return e.code }
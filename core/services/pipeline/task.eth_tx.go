@@ -29,6 +29,7 @@ type ETHTxTask struct {
 	To               string `json:"to"`
 	Data             string `json:"data"`
 	GasLimit         string `json:"gasLimit"`
+	GasPrice         string `json:"gasPrice"`
 	TxMeta           string `json:"txMeta"`
 	MinConfirmations string `json:"minConfirmations"`
 	// FailOnRevert, if set, will error the task if the transaction reverted on-chain
@@ -82,6 +83,7 @@ func (t *ETHTxTask) Run(_ context.Context, lggr logger.Logger, vars Vars, inputs
 		toAddr                AddressParam
 		data                  BytesParam
 		gasLimit              Uint64Param
+		gasPrice              MaybeBigIntParam
 		txMetaMap             MapParam
 		maybeMinConfirmations MaybeUint64Param
 		transmitCheckerMap    MapParam
@@ -92,6 +94,7 @@ func (t *ETHTxTask) Run(_ context.Context, lggr logger.Logger, vars Vars, inputs
 		errors.Wrap(ResolveParam(&toAddr, From(VarExpr(t.To, vars), NonemptyString(t.To))), "to"),
 		errors.Wrap(ResolveParam(&data, From(VarExpr(t.Data, vars), NonemptyString(t.Data))), "data"),
 		errors.Wrap(ResolveParam(&gasLimit, From(VarExpr(t.GasLimit, vars), NonemptyString(t.GasLimit), maximumGasLimit)), "gasLimit"),
+		errors.Wrap(ResolveParam(&gasPrice, From(VarExpr(t.GasPrice, vars), t.GasPrice)), "gasPrice"),
 		errors.Wrap(ResolveParam(&txMetaMap, From(VarExpr(t.TxMeta, vars), JSONWithVarExprs(t.TxMeta, vars, false), MapParam{})), "txMeta"),
 		errors.Wrap(ResolveParam(&maybeMinConfirmations, From(t.MinConfirmations)), "minConfirmations"),
 		errors.Wrap(ResolveParam(&transmitCheckerMap, From(VarExpr(t.TransmitChecker, vars), JSONWithVarExprs(t.TransmitChecker, vars, false), MapParam{})), "transmitChecker"),
@@ -112,6 +115,9 @@ func (t *ETHTxTask) Run(_ context.Context, lggr logger.Logger, vars Vars, inputs
 		return Result{Error: err}, runInfo
 	}
 	txMeta.FailOnRevert = null.BoolFrom(bool(failOnRevert))
+	if price := gasPrice.BigInt(); price != nil {
+		txMeta.GasPrice = utils.NewBig(price)
+	}
 	setJobIDOnMeta(lggr, vars, txMeta)
 
 	transmitChecker, err := decodeTransmitChecker(transmitCheckerMap)
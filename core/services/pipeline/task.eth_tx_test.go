@@ -1,6 +1,7 @@
 package pipeline_test
 
 import (
+	"math/big"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -20,6 +21,7 @@ import (
 	clnull "github.com/smartcontractkit/chainlink/core/null"
 	keystoremocks "github.com/smartcontractkit/chainlink/core/services/keystore/mocks"
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
 func TestETHTxTask(t *testing.T) {
@@ -586,3 +588,49 @@ func TestETHTxTask(t *testing.T) {
 		})
 	}
 }
+
+func TestETHTxTask_GasPrice(t *testing.T) {
+	from := common.HexToAddress("0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c")
+	to := common.HexToAddress("0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF")
+
+	tests := []struct {
+		name     string
+		gasPrice string
+		vars     pipeline.Vars
+		expected *utils.Big
+	}{
+		{"unset", "", pipeline.NewVarsFrom(nil), nil},
+		{"nil var", "$(gasPrice)", pipeline.NewVarsFrom(map[string]interface{}{"gasPrice": nil}), nil},
+		{"literal", "30000000000", pipeline.NewVarsFrom(nil), utils.NewBigI(30e9)},
+		{"var", "$(gasPrice)", pipeline.NewVarsFrom(map[string]interface{}{"gasPrice": big.NewInt(30e9)}), utils.NewBigI(30e9)},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			task := pipeline.ETHTxTask{
+				BaseTask:         pipeline.NewBaseTask(0, "ethtx", nil, nil, 0),
+				From:             `[ "0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c" ]`,
+				To:               to.String(),
+				Data:             "foobar",
+				GasLimit:         "12345",
+				GasPrice:         test.gasPrice,
+				MinConfirmations: "0",
+			}
+
+			keyStore := keystoremocks.NewEth(t)
+			txManager := txmmocks.NewTxManager(t)
+			db := pgtest.NewSqlxDB(t)
+			cfg := configtest.NewTestGeneralConfig(t)
+			cc := evmtest.NewChainSet(t, evmtest.TestChainOpts{DB: db, GeneralConfig: cfg, TxManager: txManager, KeyStore: keyStore})
+			task.HelperSetDependencies(cc, keyStore, nil, pipeline.DirectRequestJobType)
+
+			keyStore.On("GetRoundRobinAddress", testutils.FixtureChainID, from).Return(from, nil)
+			txManager.On("CreateEthTransaction", mock.MatchedBy(func(newTx txmgr.NewTx) bool {
+				return assert.Equal(t, test.expected, newTx.Meta.GasPrice)
+			})).Return(txmgr.EthTx{}, nil)
+
+			result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), test.vars, nil)
+			require.NoError(t, result.Error)
+		})
+	}
+}
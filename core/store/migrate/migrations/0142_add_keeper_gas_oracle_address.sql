@@ -0,0 +1,9 @@
+-- +goose Up
+ALTER TABLE keeper_specs
+    ADD COLUMN gas_oracle_address bytea CHECK (octet_length(gas_oracle_address) = 20),
+    ADD COLUMN gas_oracle_max_age bigint;
+
+-- +goose Down
+ALTER TABLE keeper_specs
+    DROP COLUMN gas_oracle_address,
+    DROP COLUMN gas_oracle_max_age;
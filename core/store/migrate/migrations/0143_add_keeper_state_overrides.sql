@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE keeper_specs
+    ADD COLUMN state_overrides JSONB NOT NULL DEFAULT '{}';
+
+-- +goose Down
+ALTER TABLE keeper_specs
+    DROP COLUMN state_overrides;
@@ -229,21 +229,27 @@ func NewPipelineSpec(spec *pipeline.Spec) PipelineSpec {
 
 // KeeperSpec defines the spec details of a Keeper Job
 type KeeperSpec struct {
-	ContractAddress ethkey.EIP55Address `json:"contractAddress"`
-	FromAddress     ethkey.EIP55Address `json:"fromAddress"`
-	CreatedAt       time.Time           `json:"createdAt"`
-	UpdatedAt       time.Time           `json:"updatedAt"`
-	EVMChainID      *utils.Big          `json:"evmChainID"`
+	ContractAddress  ethkey.EIP55Address    `json:"contractAddress"`
+	FromAddress      ethkey.EIP55Address    `json:"fromAddress"`
+	GasOracleAddress *ethkey.EIP55Address   `json:"gasOracleAddress"`
+	GasOracleMaxAge  models.Interval        `json:"gasOracleMaxAge"`
+	StateOverrides   map[string]interface{} `json:"stateOverrides"`
+	CreatedAt        time.Time              `json:"createdAt"`
+	UpdatedAt        time.Time              `json:"updatedAt"`
+	EVMChainID       *utils.Big             `json:"evmChainID"`
 }
 
 // NewKeeperSpec generates a new KeeperSpec from a job.KeeperSpec
 func NewKeeperSpec(spec *job.KeeperSpec) *KeeperSpec {
 	return &KeeperSpec{
-		ContractAddress: spec.ContractAddress,
-		FromAddress:     spec.FromAddress,
-		CreatedAt:       spec.CreatedAt,
-		UpdatedAt:       spec.UpdatedAt,
-		EVMChainID:      spec.EVMChainID,
+		ContractAddress:  spec.ContractAddress,
+		FromAddress:      spec.FromAddress,
+		GasOracleAddress: spec.GasOracleAddress,
+		GasOracleMaxAge:  spec.GasOracleMaxAge,
+		StateOverrides:   spec.StateOverrides,
+		CreatedAt:        spec.CreatedAt,
+		UpdatedAt:        spec.UpdatedAt,
+		EVMChainID:       spec.EVMChainID,
 	}
 }
 
@@ -317,6 +317,9 @@ func TestJob(t *testing.T) {
 						"keeperSpec": {
 							"contractAddress": "%s",
 							"fromAddress": "%s",
+							"gasOracleAddress": null,
+							"gasOracleMaxAge": "0s",
+							"stateOverrides": null,
 							"createdAt":"2000-01-01T00:00:00Z",
 							"updatedAt":"2000-01-01T00:00:00Z",
 							"evmChainID": "42"
@@ -630,6 +633,9 @@ func TestJob(t *testing.T) {
 						"keeperSpec": {
 							"contractAddress": "%s",
 							"fromAddress": "%s",
+							"gasOracleAddress": null,
+							"gasOracleMaxAge": "0s",
+							"stateOverrides": null,
 							"createdAt":"2000-01-01T00:00:00Z",
 							"updatedAt":"2000-01-01T00:00:00Z",
 							"evmChainID": "42"
@@ -295,6 +295,33 @@ func (r *KeeperSpecResolver) FromAddress() *string {
 	return &addr
 }
 
+// GasOracleAddress resolves the spec's gas oracle address.
+func (r *KeeperSpecResolver) GasOracleAddress() *string {
+	if r.spec.GasOracleAddress == nil {
+		return nil
+	}
+
+	addr := r.spec.GasOracleAddress.String()
+
+	return &addr
+}
+
+// GasOracleMaxAge resolves the spec's gas oracle max age.
+func (r *KeeperSpecResolver) GasOracleMaxAge() *string {
+	if r.spec.GasOracleMaxAge.Duration() == 0 {
+		return nil
+	}
+
+	maxAge := r.spec.GasOracleMaxAge.Duration().String()
+
+	return &maxAge
+}
+
+// StateOverrides resolves the spec's eth_call state overrides.
+func (r *KeeperSpecResolver) StateOverrides() gqlscalar.Map {
+	return gqlscalar.Map(r.spec.StateOverrides)
+}
+
 type OCRSpecResolver struct {
 	spec job.OCROracleSpec
 }
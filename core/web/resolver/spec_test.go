@@ -298,6 +298,8 @@ func TestResolver_KeeperSpec(t *testing.T) {
 	)
 	contractAddress, err := ethkey.NewEIP55Address("0x613a38AC1659769640aaE063C651F48E0250454C")
 	require.NoError(t, err)
+	gasOracleAddress, err := ethkey.NewEIP55Address("0x169E633A2D1E6c10dD91238Ba11c4A708dfEF37C")
+	require.NoError(t, err)
 
 	testCases := []GQLTestCase{
 		{
@@ -308,10 +310,15 @@ func TestResolver_KeeperSpec(t *testing.T) {
 				f.Mocks.jobORM.On("FindJobWithoutSpecErrors", id).Return(job.Job{
 					Type: job.Keeper,
 					KeeperSpec: &job.KeeperSpec{
-						ContractAddress: contractAddress,
-						CreatedAt:       f.Timestamp(),
-						EVMChainID:      utils.NewBigI(42),
-						FromAddress:     ethkey.EIP55AddressFromAddress(fromAddress),
+						ContractAddress:  contractAddress,
+						CreatedAt:        f.Timestamp(),
+						EVMChainID:       utils.NewBigI(42),
+						FromAddress:      ethkey.EIP55AddressFromAddress(fromAddress),
+						GasOracleAddress: &gasOracleAddress,
+						GasOracleMaxAge:  models.Interval(1 * time.Hour),
+						StateOverrides: job.JSONConfig{
+							"0x3cCad4715152693fE3BC4460591e3D3Fbd071b42": map[string]interface{}{"balance": "0xde0b6b3a7640000"},
+						},
 					},
 				}, nil)
 			},
@@ -326,6 +333,9 @@ func TestResolver_KeeperSpec(t *testing.T) {
 									createdAt
 									evmChainID
 									fromAddress
+									gasOracleAddress
+									gasOracleMaxAge
+									stateOverrides
 								}
 							}
 						}
@@ -340,7 +350,12 @@ func TestResolver_KeeperSpec(t *testing.T) {
 							"contractAddress": "0x613a38AC1659769640aaE063C651F48E0250454C",
 							"createdAt": "2021-01-01T00:00:00Z",
 							"evmChainID": "42",
-							"fromAddress": "0x3cCad4715152693fE3BC4460591e3D3Fbd071b42"
+							"fromAddress": "0x3cCad4715152693fE3BC4460591e3D3Fbd071b42",
+							"gasOracleAddress": "0x169E633A2D1E6c10dD91238Ba11c4A708dfEF37C",
+							"gasOracleMaxAge": "1h0m0s",
+							"stateOverrides": {
+								"0x3cCad4715152693fE3BC4460591e3D3Fbd071b42": {"balance": "0xde0b6b3a7640000"}
+							}
 						}
 					}
 				}